@@ -5,10 +5,13 @@ package pester
 
 import (
 	"bytes"
-	"errors"
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"math"
 	"math/rand"
 	"net/http"
@@ -16,6 +19,8 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -52,18 +57,61 @@ type Client struct {
 	Backoff     BackoffStrategy
 	KeepLog     bool
 
-	// A logger provided externally - timestamp may not be what is expected
+	// CheckRetry is consulted after every attempt to decide whether another
+	// retry should be made. A nil CheckRetry falls back to DefaultRetryPolicy.
+	CheckRetry CheckRetryFunc
+
+	// ErrorHandler is invoked once MaxRetries has been exhausted, giving the
+	// caller a chance to return something other than a zero-value
+	// *http.Response, log the terminal error, or wrap it. A nil ErrorHandler
+	// returns the last response and error unchanged.
+	ErrorHandler ErrorHandlerFunc
+
+	// RespectRetryAfter makes pester honor a Retry-After header on 429 and
+	// 503 responses instead of c.Backoff. Defaults to true via New().
+	RespectRetryAfter bool
+
+	// RetryAfterCap bounds how long pester will wait on a Retry-After header,
+	// so a hostile or misconfigured server can't pin a worker indefinitely.
+	// Zero means uncapped.
+	RetryAfterCap time.Duration
+
+	// DrainResponseLimit caps how many bytes of a discarded response body
+	// (one that's about to be retried, or lost to a winning concurrent
+	// attempt) are read before closing it, so the connection can be
+	// returned to the Transport's keepalive pool. Defaults to 16KB.
+	DrainResponseLimit int64
+
+	// LeveledLogger is the Logger that RequestLogHook and ResponseLogHook are
+	// called with. A nil LeveledLogger falls back to a no-op logger for the
+	// hooks. pester's own retry log (see LogRetries) is not written to it;
+	// that still goes to Logger and LogWriter below.
+	LeveledLogger Logger
+
+	// RequestLogHook, if set, is called before every attempt (including
+	// retries), letting callers inject trace IDs, log headers, or start timers.
+	RequestLogHook func(logger Logger, req *http.Request, attempt int)
+
+	// ResponseLogHook, if set, is called after every response, whether it
+	// succeeded or failed, letting callers log status, timing, or emit metrics.
+	ResponseLogHook func(logger Logger, resp *http.Response)
+
+	// Deprecated: a logger provided externally - timestamp may not be what is
+	// expected. Use LeveledLogger instead.
 	Logger func(string)
 
-	// LogWriter is used to send a pre-formatted log message to (e.g. STDOUT/ERR)
+	// Deprecated: LogWriter is used to send a pre-formatted log message to
+	// (e.g. STDOUT/ERR). Use LeveledLogger instead.
 	LogWriter io.Writer
 
 	// LogRetries enables logging of retries when they happen
 	LogRetries bool
 
-	// Verbosity of debug messages; 0 is no debug (info only), 3 is most verbose.
+	// Deprecated: Verbosity of debug messages; 0 is no debug (info only), 3
+	// is most verbose. Unused by LeveledLogger.
 	Verbosity int
-	// Threshhold - Minimum log level to output to console (info, warn, error, or fatal)
+	// Deprecated: Threshhold - Minimum log level to output to console (info,
+	// warn, error, or fatal). Unused by LeveledLogger.
 	Threshold string
 
 	// LogTimeFormat is used to format the time when LogRetries is true
@@ -72,6 +120,10 @@ type Client struct {
 	SuccessReqNum   int
 	SuccessRetryNum int
 
+	// randMu guards rnd, the per-Client random source used by jitter.
+	randMu sync.Mutex
+	rnd    *rand.Rand
+
 	sync.Mutex
 	ErrLog []ErrEntry
 }
@@ -98,75 +150,332 @@ type result struct {
 
 // params represents all the params needed to run http client calls and pester errors
 type params struct {
+	ctx      context.Context
 	method   string
 	verb     string
 	req      *http.Request
 	url      string
 	bodyType string
-	body     io.Reader
+	bodyFn   ReaderFunc
 	data     url.Values
 }
 
+// ReaderFunc returns a fresh io.Reader for a single request attempt. pester
+// calls it again before every retry so a request body can be re-read
+// without buffering the whole thing in memory up front.
+type ReaderFunc func() (io.Reader, error)
+
+// NewRequest builds an *http.Request together with a ReaderFunc that knows
+// how to hand pester a fresh, re-readable body on every retry attempt.
+// body may be nil, []byte, *bytes.Buffer, *bytes.Reader, *strings.Reader,
+// an io.ReadSeeker, a bare io.Reader, or a ReaderFunc; anything else is an
+// error. Byte slices, buffers, and seekable readers are reused directly;
+// a bare io.Reader is buffered once as a fallback. A seekable body is a
+// single shared reader, so with Client.Concurrency > 1 its attempts read it
+// one at a time rather than truly in parallel; pass []byte or *bytes.Buffer
+// instead if you need concurrent attempts that don't serialize on the body.
+func NewRequest(method, url string, body interface{}) (*http.Request, ReaderFunc, error) {
+	bodyFn, err := readerFuncFor(body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rc io.Reader
+	if bodyFn != nil {
+		if rc, err = bodyFn(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	req, err := http.NewRequest(method, url, rc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return req, bodyFn, nil
+}
+
+// readerFuncFor adapts any of NewRequest's supported body types into a
+// ReaderFunc.
+func readerFuncFor(body interface{}) (ReaderFunc, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case ReaderFunc:
+		return b, nil
+	case []byte:
+		return func() (io.Reader, error) {
+			return bytes.NewReader(b), nil
+		}, nil
+	case *bytes.Buffer:
+		buf := b.Bytes()
+		return func() (io.Reader, error) {
+			return bytes.NewReader(buf), nil
+		}, nil
+	case *bytes.Reader, *strings.Reader, io.ReadSeeker:
+		rs := body.(io.ReadSeeker)
+		// rs is shared by every attempt, so concurrent attempts (Client.Concurrency
+		// > 1) must not Seek/Read it at the same time or they'll race and corrupt
+		// each other's view of the body. Serialize access: each call locks mu,
+		// rewinds rs, and hands back a reader that only releases the lock once
+		// the caller is done reading it (http's Transport closes request bodies
+		// after use), so at most one concurrent attempt touches rs at a time.
+		var mu sync.Mutex
+		return func() (io.Reader, error) {
+			mu.Lock()
+			if _, err := rs.Seek(0, io.SeekStart); err != nil {
+				mu.Unlock()
+				return nil, err
+			}
+			return &lockedReader{rs: rs, unlock: &mu}, nil
+		}, nil
+	case io.Reader:
+		buf, err := ioutil.ReadAll(b)
+		if err != nil {
+			return nil, err
+		}
+		return func() (io.Reader, error) {
+			return bytes.NewReader(buf), nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("pester: unsupported body type %T", body)
+	}
+}
+
+// lockedReader wraps a shared io.ReadSeeker so only one concurrent attempt
+// reads it at a time. It releases unlock exactly once, on the first Close or
+// error/EOF, so the next attempt's call into the same ReaderFunc can proceed.
+type lockedReader struct {
+	rs       io.Reader
+	unlock   *sync.Mutex
+	unlocked bool
+}
+
+func (l *lockedReader) Read(p []byte) (int, error) {
+	n, err := l.rs.Read(p)
+	if err != nil {
+		l.release()
+	}
+	return n, err
+}
+
+// Close releases the lock but leaves rs open, so the next attempt can rewind
+// and re-read it.
+func (l *lockedReader) Close() error {
+	l.release()
+	return nil
+}
+
+func (l *lockedReader) release() {
+	if !l.unlocked {
+		l.unlocked = true
+		l.unlock.Unlock()
+	}
+}
+
+// Logger is a small structured logging interface that Client.RequestLogHook
+// and Client.ResponseLogHook are called with. Its method set is deliberately
+// minimal so that loggers with a leveled, keys-and-values call shape (e.g.
+// logr.Logger, *zap.SugaredLogger) are easy to adapt to it; pester ships
+// StdLogAdapter, a thin shim for the standard library's *log.Logger.
+type Logger interface {
+	Debug(msg string, kv ...interface{})
+	Info(msg string, kv ...interface{})
+	Warn(msg string, kv ...interface{})
+	Error(msg string, kv ...interface{})
+}
+
+// StdLogAdapter adapts a *log.Logger to the Logger interface. The standard
+// logger has no notion of level, so every call is printed with its level
+// prefixed.
+type StdLogAdapter struct {
+	*log.Logger
+}
+
+// Debug implements Logger.
+func (a StdLogAdapter) Debug(msg string, kv ...interface{}) { a.logf("DEBUG", msg, kv) }
+
+// Info implements Logger.
+func (a StdLogAdapter) Info(msg string, kv ...interface{}) { a.logf("INFO", msg, kv) }
+
+// Warn implements Logger.
+func (a StdLogAdapter) Warn(msg string, kv ...interface{}) { a.logf("WARN", msg, kv) }
+
+// Error implements Logger.
+func (a StdLogAdapter) Error(msg string, kv ...interface{}) { a.logf("ERROR", msg, kv) }
+
+func (a StdLogAdapter) logf(level, msg string, kv []interface{}) {
+	if len(kv) == 0 {
+		a.Logger.Printf("%s %s", level, msg)
+		return
+	}
+	a.Logger.Printf("%s %s %v", level, msg, kv)
+}
+
+// noopLogger discards everything; it's used when Client.LeveledLogger is nil
+// so RequestLogHook/ResponseLogHook always have a usable Logger.
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+
+// logger returns c.LeveledLogger, or a no-op Logger if it's unset.
+func (c *Client) logger() Logger {
+	if c.LeveledLogger != nil {
+		return c.LeveledLogger
+	}
+	return noopLogger{}
+}
+
 // New constructs a new DefaultClient with sensible default values
 func New() *Client {
 	return &Client{
-		Concurrency:   DefaultClient.Concurrency,
-		MaxRetries:    DefaultClient.MaxRetries,
-		Backoff:       DefaultClient.Backoff,
-		ErrLog:        DefaultClient.ErrLog,
-		LogRetries:    true,
-		Verbosity:     2,
-		Threshold:     "info",
-		LogTimeFormat: LogStringTimeFormat,
+		Concurrency:       DefaultClient.Concurrency,
+		MaxRetries:        DefaultClient.MaxRetries,
+		Backoff:           DefaultClient.Backoff,
+		ErrLog:            DefaultClient.ErrLog,
+		LogRetries:        true,
+		Verbosity:         2,
+		Threshold:         "info",
+		LogTimeFormat:     LogStringTimeFormat,
+		RespectRetryAfter: true,
 	}
 }
 
-// BackoffStrategy is used to determine how long a retry request should wait until attempted
-type BackoffStrategy func(retry int) time.Duration
+// BackoffStrategy is used to determine how long a retry request should wait
+// until attempted. resp and err are the result of the attempt that's being
+// retried, so a strategy can adapt to the failure (e.g. backing off harder
+// on a 503 than on a dropped connection).
+//
+// This widens pester's original, attempt-only BackoffStrategy shape (see
+// LegacyBackoffStrategy). That is a source break for callers who assigned a
+// package-level strategy directly, e.g. Client.Backoff = pester.LinearBackoff
+// no longer compiles; such call sites need Client.Backoff =
+// pester.AdaptBackoffStrategy(pester.LinearBackoff) instead.
+type BackoffStrategy func(attempt int, resp *http.Response, err error) time.Duration
+
+// LegacyBackoffStrategy is pester's original BackoffStrategy shape, a
+// function of the attempt number alone. Wrap one with AdaptBackoffStrategy
+// to use it as Client.Backoff.
+type LegacyBackoffStrategy func(retry int) time.Duration
+
+// AdaptBackoffStrategy adapts an old-style, attempt-only backoff strategy to
+// the current BackoffStrategy signature, ignoring the response and error.
+func AdaptBackoffStrategy(fn LegacyBackoffStrategy) BackoffStrategy {
+	return func(attempt int, _ *http.Response, _ error) time.Duration {
+		return fn(attempt)
+	}
+}
+
+// CheckRetryFunc is consulted after every attempt to decide whether pester
+// should retry. Modeled after hashicorp/go-retryablehttp's CheckRetry.
+type CheckRetryFunc func(ctx context.Context, resp *http.Response, err error) (bool, error)
+
+// ErrorHandlerFunc is invoked once MaxRetries has been exhausted, so callers
+// can substitute a response, log the terminal failure, or wrap the error.
+type ErrorHandlerFunc func(resp *http.Response, err error, numTries int) (*http.Response, error)
+
+// DefaultRetryPolicy is used when Client.CheckRetry is nil. To keep existing
+// callers unaffected, it preserves pester's original behavior of retrying on
+// any non-2xx/3xx response in addition to network errors, and it stops
+// retrying once the context is done.
+func DefaultRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	if err != nil {
+		return true, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return true, nil
+	}
+
+	return false, nil
+}
 
 // DefaultClient provides sensible defaults
-var DefaultClient = &Client{Concurrency: 1, MaxRetries: 3, Backoff: DefaultBackoff, ErrLog: []ErrEntry{}}
+var DefaultClient = &Client{Concurrency: 1, MaxRetries: 3, Backoff: AdaptBackoffStrategy(DefaultBackoff), ErrLog: []ErrEntry{}}
 
-// DefaultBackoff always returns 1 second
+// DefaultBackoff always returns 1 second.
+//
+// This is the original, attempt-only BackoffStrategy shape; wrap it with
+// AdaptBackoffStrategy to use it as Client.Backoff.
 func DefaultBackoff(_ int) time.Duration {
 	return 1 * time.Second
 }
 
-// ExponentialBackoff returns ever increasing backoffs by a power of 2
+// ExponentialBackoff returns ever increasing backoffs by a power of 2.
+//
+// This is the original, attempt-only BackoffStrategy shape; wrap it with
+// AdaptBackoffStrategy to use it as Client.Backoff.
 func ExponentialBackoff(i int) time.Duration {
 	return time.Duration(math.Pow(2, float64(i))) * time.Second
 }
 
 // ExponentialJitterBackoff returns ever increasing backoffs by a power of 2
 // with +/- 0-33% to prevent sychronized reuqests.
+//
+// Deprecated: this draws randomness from DefaultClient's jitter source, so
+// concurrent Clients using it share one *rand.Rand. Prefer a Client's own
+// ExponentialJitterBackoff method, which jitters from that Client alone.
 func ExponentialJitterBackoff(i int) time.Duration {
-	return jitter(int(math.Pow(2, float64(i))))
+	return DefaultClient.jitter(int(math.Pow(2, float64(i))))
 }
 
-// LinearBackoff returns increasing durations, each a second longer than the last
+// LinearBackoff returns increasing durations, each a second longer than the last.
+//
+// This is the original, attempt-only BackoffStrategy shape; wrap it with
+// AdaptBackoffStrategy to use it as Client.Backoff.
 func LinearBackoff(i int) time.Duration {
 	return time.Duration(i) * time.Second
 }
 
 // LinearJitterBackoff returns increasing durations, each a second longer than the last
 // with +/- 0-33% to prevent sychronized reuqests.
+//
+// Deprecated: this draws randomness from DefaultClient's jitter source, so
+// concurrent Clients using it share one *rand.Rand. Prefer a Client's own
+// LinearJitterBackoff method, which jitters from that Client alone.
 func LinearJitterBackoff(i int) time.Duration {
-	return jitter(i)
+	return DefaultClient.jitter(i)
 }
 
-// jitter keeps the +/- 0-33% logic in one place
-func jitter(i int) time.Duration {
-	ms := i * 1000
+// ExponentialJitterBackoff is like the package-level function of the same
+// name, but draws its randomness from c alone instead of a shared source.
+func (c *Client) ExponentialJitterBackoff(i int, _ *http.Response, _ error) time.Duration {
+	return c.jitter(int(math.Pow(2, float64(i))))
+}
+
+// LinearJitterBackoff is like the package-level function of the same name,
+// but draws its randomness from c alone instead of a shared source.
+func (c *Client) LinearJitterBackoff(i int, _ *http.Response, _ error) time.Duration {
+	return c.jitter(i)
+}
 
+// jitter applies +/- 0-33% jitter to i seconds, drawing from c's own
+// *rand.Rand so concurrent workers across different Clients never share a
+// random source (and, unlike a rand.Seed-per-call, never collide with one
+// another within the same Client either).
+func (c *Client) jitter(i int) time.Duration {
+	ms := i * 1000
 	maxJitter := ms / 3
 
-	rand.Seed(time.Now().Unix())
-	jitter := rand.Intn(maxJitter + 1)
+	c.randMu.Lock()
+	if c.rnd == nil {
+		c.rnd = rand.New(rand.NewSource(cryptoSeed()))
+	}
+	jit := c.rnd.Intn(maxJitter + 1)
+	grow := c.rnd.Intn(2) == 1
+	c.randMu.Unlock()
 
-	if rand.Intn(2) == 1 {
-		ms = ms + jitter
+	if grow {
+		ms = ms + jit
 	} else {
-		ms = ms - jitter
+		ms = ms - jit
 	}
 
 	// a jitter of 0 messes up the time.Tick chan
@@ -177,10 +486,111 @@ func jitter(i int) time.Duration {
 	return time.Duration(ms) * time.Millisecond
 }
 
+// DecorrelatedJitterBackoff returns a BackoffStrategy implementing AWS's
+// decorrelated-jitter algorithm (sleep = min(cap, random_between(base,
+// prev*3))), which spreads retries out better than a fixed
+// exponential-with-jitter curve. The returned strategy carries its own
+// randomness and previous-sleep state, so give each Client its own instance
+// rather than sharing one across Clients.
+func DecorrelatedJitterBackoff(base, cap time.Duration) BackoffStrategy {
+	var mu sync.Mutex
+	rnd := rand.New(rand.NewSource(cryptoSeed()))
+	prev := base
+
+	return func(_ int, _ *http.Response, _ error) time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+
+		top := prev * 3
+		if top <= base {
+			top = base + 1
+		}
+		sleep := base + time.Duration(rnd.Int63n(int64(top-base)))
+		if sleep > cap {
+			sleep = cap
+		}
+		prev = sleep
+		return sleep
+	}
+}
+
+// cryptoSeed produces a random seed for math/rand from a cryptographic
+// source, replacing the old rand.Seed(time.Now().Unix()) pattern that could
+// hand identical seeds to goroutines started within the same second.
+func cryptoSeed() int64 {
+	var b [8]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		return time.Now().UnixNano()
+	}
+	return int64(binary.BigEndian.Uint64(b[:]))
+}
+
+// retryAfter parses a Retry-After header (RFC 7231 §7.1.3) off of a 429 or
+// 503 response, as either delta-seconds or an HTTP-date, and reports how
+// long to wait before retrying. ok is false if the header is absent, the
+// status isn't one Retry-After applies to, or the value can't be parsed.
+func retryAfter(resp *http.Response) (wait time.Duration, ok bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	h := resp.Header.Get("Retry-After")
+	if h == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(h); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(h); err == nil {
+		wait = time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// defaultDrainResponseLimit is used when Client.DrainResponseLimit is unset.
+const defaultDrainResponseLimit = 16 * 1024
+
+// drainResponseLimit returns c.DrainResponseLimit, falling back to
+// defaultDrainResponseLimit when it isn't set.
+func (c *Client) drainResponseLimit() int64 {
+	if c.DrainResponseLimit > 0 {
+		return c.DrainResponseLimit
+	}
+	return defaultDrainResponseLimit
+}
+
+// drainAndClose reads up to limit bytes of resp.Body and closes it, so a
+// response we're about to discard (one that's being retried, or one lost to
+// a winning concurrent attempt) still lets the Transport reuse the
+// underlying connection instead of leaking it.
+func drainAndClose(resp *http.Response, limit int64) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, limit))
+	resp.Body.Close()
+}
+
 // pester provides all the logic of retries, concurrency, backoff, and logging
 func (c *Client) pester(p params) (*http.Response, error) {
 	resultCh := make(chan result)
-	finishCh := make(chan struct{})
+
+	reqCtx := p.ctx
+	if reqCtx == nil {
+		reqCtx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(reqCtx)
+	defer cancel()
 
 	// GET calls should be idempotent and can make use
 	// of concurrency. Other verbs can mutate and should not
@@ -198,24 +608,6 @@ func (c *Client) pester(p params) (*http.Response, error) {
 		Timeout:       c.hc.Timeout,
 	}
 
-	// if we have a request body, we need to save it for later
-	var originalRequestBody []byte
-	var originalBody []byte
-	var err error
-	if p.req != nil && p.req.Body != nil {
-		originalRequestBody, err = ioutil.ReadAll(p.req.Body)
-		if err != nil {
-			return &http.Response{}, errors.New("error reading request body")
-		}
-		p.req.Body.Close()
-	}
-	if p.body != nil {
-		originalBody, err = ioutil.ReadAll(p.body)
-		if err != nil {
-			return &http.Response{}, errors.New("error reading body")
-		}
-	}
-
 	for req := 0; req < concurrency; req++ {
 		go func(n int, p params) {
 			resp := &http.Response{}
@@ -223,34 +615,80 @@ func (c *Client) pester(p params) (*http.Response, error) {
 
 			for i := 0; i < c.MaxRetries; i++ {
 				select {
-				case <-finishCh:
+				case <-ctx.Done():
 					return
 				default:
 				}
-				// rehydrate the body (it is drained each read)
-				if len(originalRequestBody) > 0 {
-					p.req.Body = ioutil.NopCloser(bytes.NewBuffer(originalRequestBody))
-				}
-				if len(originalBody) > 0 {
-					p.body = bytes.NewBuffer(originalBody)
+
+				// ask for a fresh body for this attempt instead of
+				// buffering the whole thing in memory up front
+				var bodyReader io.Reader
+				if p.bodyFn != nil {
+					if bodyReader, err = p.bodyFn(); err != nil {
+						resultCh <- result{resp: &http.Response{}, err: err, req: n, retry: i}
+						return
+					}
 				}
-				// route the calls
+
+				// build the request for this attempt; route the calls,
+				// attaching ctx so an in-flight attempt is actually aborted
+				// when another concurrent worker wins or the caller's
+				// context is done
+				var req *http.Request
 				switch p.method {
 				case "Do":
-					resp, err = httpClient.Do(p.req)
+					req = p.req
+					if rc, ok := bodyReader.(io.ReadCloser); ok {
+						req.Body = rc
+					} else if bodyReader != nil {
+						req.Body = ioutil.NopCloser(bodyReader)
+					}
 				case "Get":
-					resp, err = httpClient.Get(p.url)
+					req, err = http.NewRequest("GET", p.url, nil)
 				case "Head":
-					resp, err = httpClient.Head(p.url)
+					req, err = http.NewRequest("HEAD", p.url, nil)
 				case "Post":
-					resp, err = httpClient.Post(p.url, p.bodyType, p.body)
+					if req, err = http.NewRequest("POST", p.url, bodyReader); err == nil {
+						req.Header.Set("Content-Type", p.bodyType)
+					}
 				case "PostForm":
-					resp, err = httpClient.PostForm(p.url, p.data)
+					if req, err = http.NewRequest("POST", p.url, strings.NewReader(p.data.Encode())); err == nil {
+						req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+					}
+				}
+
+				if err == nil && req != nil {
+					req = req.WithContext(ctx)
+					if c.RequestLogHook != nil {
+						c.RequestLogHook(c.logger(), req, i)
+					}
+					resp, err = httpClient.Do(req)
+				}
+				if resp == nil {
+					resp = &http.Response{}
 				}
 
-				// 200 and 300 level errors are considered success and we are done
-				if err == nil && resp.StatusCode < 400 {
-					resultCh <- result{resp: resp, err: err, req: n, retry: i}
+				if c.ResponseLogHook != nil {
+					c.ResponseLogHook(c.logger(), resp)
+				}
+
+				checkRetry := c.CheckRetry
+				if checkRetry == nil {
+					checkRetry = DefaultRetryPolicy
+				}
+
+				shouldRetry, checkErr := checkRetry(ctx, resp, err)
+				if checkErr != nil {
+					err = checkErr
+				}
+				if !shouldRetry {
+					select {
+					case resultCh <- result{resp: resp, err: err, req: n, retry: i}:
+					case <-ctx.Done():
+						// a different concurrent attempt already won; this
+						// response is being thrown away
+						drainAndClose(resp, c.drainResponseLimit())
+					}
 					return
 				}
 
@@ -268,20 +706,55 @@ func (c *Client) pester(p params) (*http.Response, error) {
 					Err:     err,
 				})
 
+				// this response is about to be retried; drain and close it
+				// so the Transport can reuse the connection
+				if i < c.MaxRetries-1 {
+					drainAndClose(resp, c.drainResponseLimit())
+				}
+
+				wait := c.Backoff(i, resp, err)
+				if c.RespectRetryAfter {
+					if ra, ok := retryAfter(resp); ok {
+						wait = ra
+						if c.RetryAfterCap > 0 && wait > c.RetryAfterCap {
+							wait = c.RetryAfterCap
+						}
+					}
+				}
+
 				// prevent a 0 from causing the tick to block, pass additional microsecond
-				<-time.Tick(c.Backoff(i) + 1*time.Microsecond)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.Tick(wait + 1*time.Microsecond):
+				}
+			}
+
+			if c.ErrorHandler != nil {
+				resp, err = c.ErrorHandler(resp, err, c.MaxRetries)
+			}
+			select {
+			case resultCh <- result{resp: resp, err: err}:
+			case <-ctx.Done():
+				// a different concurrent attempt already won; this
+				// response is being thrown away
+				drainAndClose(resp, c.drainResponseLimit())
 			}
-			resultCh <- result{resp: resp, err: err}
 		}(req, p)
 	}
 
 	for {
 		select {
 		case res := <-resultCh:
-			close(finishCh)
+			// stop the other concurrent workers; cancellation propagates to
+			// their in-flight requests via the ctx attached to each attempt
+			cancel()
 			c.SuccessReqNum = res.req
 			c.SuccessRetryNum = res.retry
 			return res.resp, res.err
+		case <-ctx.Done():
+			// the caller's context is done before any worker reported
+			return &http.Response{}, ctx.Err()
 		}
 	}
 }
@@ -344,27 +817,87 @@ func (c *Client) log(e ErrEntry) {
 
 // Do provides the same functionality as http.Client.Do
 func (c *Client) Do(req *http.Request) (resp *http.Response, err error) {
-	return c.pester(params{method: "Do", req: req, verb: req.Method, url: req.URL.String()})
+	return c.DoWithContext(context.Background(), req)
+}
+
+// DoWithContext is like Do, but observes ctx cancellation across retries and
+// concurrent attempts.
+func (c *Client) DoWithContext(ctx context.Context, req *http.Request) (resp *http.Response, err error) {
+	var bodyFn ReaderFunc
+	if req.Body != nil {
+		var err error
+		if bodyFn, err = readerFuncFor(req.Body); err != nil {
+			return &http.Response{}, err
+		}
+		// a seekable body is rewound and re-read on every attempt, so it
+		// must stay open; any other body has been buffered already
+		if _, seekable := req.Body.(io.ReadSeeker); !seekable {
+			req.Body.Close()
+		}
+	}
+	return c.pester(params{ctx: ctx, method: "Do", req: req, bodyFn: bodyFn, verb: req.Method, url: req.URL.String()})
+}
+
+// DoReader is like Do, but takes an explicit ReaderFunc (as returned by
+// NewRequest) so a large or streamed request body can be re-read on every
+// retry attempt instead of being buffered into memory up front.
+func (c *Client) DoReader(req *http.Request, body ReaderFunc) (resp *http.Response, err error) {
+	if req.Body != nil {
+		req.Body.Close()
+		req.Body = nil
+	}
+	return c.pester(params{ctx: context.Background(), method: "Do", req: req, bodyFn: body, verb: req.Method, url: req.URL.String()})
 }
 
 // Get provides the same functionality as http.Client.Get
 func (c *Client) Get(url string) (resp *http.Response, err error) {
-	return c.pester(params{method: "Get", url: url, verb: "GET"})
+	return c.GetWithContext(context.Background(), url)
+}
+
+// GetWithContext is like Get, but observes ctx cancellation across retries
+// and concurrent attempts.
+func (c *Client) GetWithContext(ctx context.Context, url string) (resp *http.Response, err error) {
+	return c.pester(params{ctx: ctx, method: "Get", url: url, verb: "GET"})
 }
 
 // Head provides the same functionality as http.Client.Head
 func (c *Client) Head(url string) (resp *http.Response, err error) {
-	return c.pester(params{method: "Head", url: url, verb: "HEAD"})
+	return c.HeadWithContext(context.Background(), url)
+}
+
+// HeadWithContext is like Head, but observes ctx cancellation across retries
+// and concurrent attempts.
+func (c *Client) HeadWithContext(ctx context.Context, url string) (resp *http.Response, err error) {
+	return c.pester(params{ctx: ctx, method: "Head", url: url, verb: "HEAD"})
 }
 
 // Post provides the same functionality as http.Client.Post
 func (c *Client) Post(url string, bodyType string, body io.Reader) (resp *http.Response, err error) {
-	return c.pester(params{method: "Post", url: url, bodyType: bodyType, body: body, verb: "POST"})
+	return c.PostWithContext(context.Background(), url, bodyType, body)
+}
+
+// PostWithContext is like Post, but observes ctx cancellation across retries
+// and concurrent attempts.
+func (c *Client) PostWithContext(ctx context.Context, url string, bodyType string, body io.Reader) (resp *http.Response, err error) {
+	var bodyFn ReaderFunc
+	if body != nil {
+		var err error
+		if bodyFn, err = readerFuncFor(body); err != nil {
+			return &http.Response{}, err
+		}
+	}
+	return c.pester(params{ctx: ctx, method: "Post", url: url, bodyType: bodyType, bodyFn: bodyFn, verb: "POST"})
 }
 
 // PostForm provides the same functionality as http.Client.PostForm
 func (c *Client) PostForm(url string, data url.Values) (resp *http.Response, err error) {
-	return c.pester(params{method: "PostForm", url: url, data: data, verb: "POST"})
+	return c.PostFormWithContext(context.Background(), url, data)
+}
+
+// PostFormWithContext is like PostForm, but observes ctx cancellation across
+// retries and concurrent attempts.
+func (c *Client) PostFormWithContext(ctx context.Context, url string, data url.Values) (resp *http.Response, err error) {
+	return c.pester(params{ctx: ctx, method: "PostForm", url: url, data: data, verb: "POST"})
 }
 
 ////////////////////////////////////////
@@ -0,0 +1,139 @@
+package pester
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	future := time.Now().Add(5 * time.Second).UTC().Format(http.TimeFormat)
+
+	cases := []struct {
+		name       string
+		statusCode int
+		header     string
+		wantOK     bool
+		wantWait   time.Duration
+	}{
+		{"delta-seconds on 429", http.StatusTooManyRequests, "2", true, 2 * time.Second},
+		{"delta-seconds on 503", http.StatusServiceUnavailable, "7", true, 7 * time.Second},
+		{"http-date", http.StatusServiceUnavailable, future, true, 5 * time.Second},
+		{"missing header", http.StatusServiceUnavailable, "", false, 0},
+		{"garbage value", http.StatusServiceUnavailable, "not-a-wait", false, 0},
+		{"negative delta-seconds", http.StatusServiceUnavailable, "-5", false, 0},
+		{"status not applicable", http.StatusInternalServerError, "2", false, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: tc.statusCode, Header: http.Header{}}
+			if tc.header != "" {
+				resp.Header.Set("Retry-After", tc.header)
+			}
+
+			wait, ok := retryAfter(resp)
+			if ok != tc.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !ok {
+				return
+			}
+			// the http-date case is computed from time.Now(), so allow slop
+			if diff := wait - tc.wantWait; diff < -time.Second || diff > time.Second {
+				t.Fatalf("wait = %v, want ~%v", wait, tc.wantWait)
+			}
+		})
+	}
+}
+
+func TestRetryAfterNilResponse(t *testing.T) {
+	if _, ok := retryAfter(nil); ok {
+		t.Fatal("retryAfter(nil) should report ok = false")
+	}
+}
+
+func TestConcurrentAttemptsCanceledOnWinner(t *testing.T) {
+	var started int32
+	canceled := make(chan struct{}, 10)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&started, 1) == 1 {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+			canceled <- struct{}{}
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	c := New()
+	c.Concurrency = 5
+	c.MaxRetries = 1
+
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+
+	select {
+	case <-canceled:
+	case <-time.After(1 * time.Second):
+		t.Fatal("expected a losing concurrent attempt's request to be canceled, not just abandoned")
+	}
+}
+
+func TestDoWithContextCancellation(t *testing.T) {
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-unblock:
+		}
+	}))
+	defer srv.Close()
+	defer close(unblock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c := New()
+	c.MaxRetries = 1
+	if _, err := c.GetWithContext(ctx, srv.URL); err == nil {
+		t.Fatal("expected an error once the caller's context was done")
+	}
+}
+
+func TestClientJitterWithinBounds(t *testing.T) {
+	c := New()
+	for i := 1; i <= 10; i++ {
+		for attempt := 0; attempt < 50; attempt++ {
+			ms := i * 1000
+			maxJitter := ms / 3
+			got := c.jitter(i)
+			if got < time.Duration(ms-maxJitter)*time.Millisecond || got > time.Duration(ms+maxJitter)*time.Millisecond {
+				t.Fatalf("jitter(%d) = %v, outside +/-33%% of %v", i, got, time.Duration(ms)*time.Millisecond)
+			}
+		}
+	}
+}
+
+func TestDecorrelatedJitterBackoffWithinBounds(t *testing.T) {
+	base := 100 * time.Millisecond
+	cap := 1 * time.Second
+	strategy := DecorrelatedJitterBackoff(base, cap)
+
+	for attempt := 1; attempt <= 100; attempt++ {
+		wait := strategy(attempt, nil, nil)
+		if wait < base || wait > cap {
+			t.Fatalf("attempt %d: wait = %v, want between %v and %v", attempt, wait, base, cap)
+		}
+	}
+}